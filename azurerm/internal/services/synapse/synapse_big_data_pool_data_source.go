@@ -0,0 +1,176 @@
+package synapse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSynapseBigDataPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSynapseBigDataPoolRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SynapseBigDataPoolName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SynapseWorkspaceID,
+			},
+
+			"node_size_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"node_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"node_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"auto_scale": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"auto_pause": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay_in_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"spark_events_folder": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"spark_log_folder": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"spark_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"library_requirement": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"content_base64": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source_file": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"filename": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceArmSynapseBigDataPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.BigDataPoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	workspaceId, err := parse.SynapseWorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Synapse BigDataPool %q (Resource Group %q / workspaceName %q) was not found", name, workspaceId.ResourceGroup, workspaceId.Name)
+		}
+		return fmt.Errorf("retrieving Synapse BigDataPool %q (Resource Group %q / workspaceName %q): %+v", name, workspaceId.ResourceGroup, workspaceId.Name, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("empty or nil ID returned for Synapse BigDataPool %q (Resource Group %q / workspaceName %q) ID", name, workspaceId.ResourceGroup, workspaceId.Name)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("synapse_workspace_id", workspaceId.String())
+	if props := resp.BigDataPoolResourceProperties; props != nil {
+		if err := d.Set("auto_pause", flattenArmBigDataPoolAutoPauseProperties(props.AutoPause)); err != nil {
+			return fmt.Errorf("setting `auto_pause`: %+v", err)
+		}
+		if err := d.Set("auto_scale", flattenArmBigDataPoolAutoScaleProperties(props.AutoScale)); err != nil {
+			return fmt.Errorf("setting `auto_scale`: %+v", err)
+		}
+		if err := d.Set("library_requirement", flattenArmBigDataPoolLibraryRequirements(props.LibraryRequirements, nil)); err != nil {
+			return fmt.Errorf("setting `library_requirement`: %+v", err)
+		}
+		d.Set("node_count", props.NodeCount)
+		d.Set("node_size", props.NodeSize)
+		d.Set("node_size_family", string(props.NodeSizeFamily))
+		d.Set("spark_events_folder", props.SparkEventsFolder)
+		d.Set("spark_log_folder", props.DefaultSparkLogFolder)
+		d.Set("spark_version", props.SparkVersion)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
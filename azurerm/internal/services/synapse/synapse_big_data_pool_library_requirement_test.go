@@ -0,0 +1,196 @@
+package synapse
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_Content(t *testing.T) {
+	content, filename, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "numpy==1.19.0\npandas==1.1.0\n",
+		"content_base64": "",
+		"source_file":    "",
+		"filename":       "",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if content != "numpy==1.19.0\npandas==1.1.0\n" {
+		t.Fatalf("unexpected content: %q", content)
+	}
+	if filename != "requirements.txt" {
+		t.Fatalf("expected filename to be auto-detected as requirements.txt, got %q", filename)
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_ContentDetectsCondaEnvironment(t *testing.T) {
+	_, filename, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "name: myenv\ndependencies:\n  - numpy\n",
+		"content_base64": "",
+		"source_file":    "",
+		"filename":       "",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if filename != "environment.yml" {
+		t.Fatalf("expected filename to be auto-detected as environment.yml, got %q", filename)
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_ContentBase64(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("numpy==1.19.0\n"))
+
+	content, filename, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "",
+		"content_base64": encoded,
+		"source_file":    "",
+		"filename":       "requirements.txt",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if content != "numpy==1.19.0\n" {
+		t.Fatalf("unexpected decoded content: %q", content)
+	}
+	if filename != "requirements.txt" {
+		t.Fatalf("unexpected filename: %q", filename)
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_ContentBase64Invalid(t *testing.T) {
+	_, _, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "",
+		"content_base64": "not-valid-base64!!",
+		"source_file":    "",
+		"filename":       "requirements.txt",
+	})
+	if err == nil {
+		t.Fatal("expected an error decoding invalid `content_base64`, got none")
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_SourceFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "synapse-big-data-pool")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	sourceFile := filepath.Join(dir, "environment.yml")
+	if err := ioutil.WriteFile(sourceFile, []byte("dependencies:\n  - numpy\n"), 0600); err != nil {
+		t.Fatalf("writing fixture file: %+v", err)
+	}
+
+	content, filename, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "",
+		"content_base64": "",
+		"source_file":    sourceFile,
+		"filename":       "",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if content != "dependencies:\n  - numpy\n" {
+		t.Fatalf("unexpected content read from `source_file`: %q", content)
+	}
+	if filename != "environment.yml" {
+		t.Fatalf("expected filename to be derived from `source_file`'s base name, got %q", filename)
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_NoSourceSet(t *testing.T) {
+	_, _, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "",
+		"content_base64": "",
+		"source_file":    "",
+		"filename":       "",
+	})
+	if err == nil {
+		t.Fatal("expected an error when none of `content`, `content_base64` or `source_file` is set")
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_MultipleSourcesSet(t *testing.T) {
+	_, _, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "numpy==1.19.0\n",
+		"content_base64": "",
+		"source_file":    "/tmp/requirements.txt",
+		"filename":       "",
+	})
+	if err == nil {
+		t.Fatal("expected an error when more than one of `content`, `content_base64` or `source_file` is set")
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirementContent_FilenameExtensionMismatch(t *testing.T) {
+	_, _, err := expandArmBigDataPoolLibraryRequirementContent(map[string]interface{}{
+		"content":        "numpy==1.19.0\n",
+		"content_base64": "",
+		"source_file":    "",
+		"filename":       "requirements.csv",
+	})
+	if err == nil {
+		t.Fatal("expected an error when `filename`'s extension doesn't match a recognised library requirement type")
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirements_SinglePip(t *testing.T) {
+	requirement, err := expandArmBigDataPoolLibraryRequirements([]interface{}{
+		map[string]interface{}{
+			"content":        "numpy==1.19.0\n",
+			"content_base64": "",
+			"source_file":    "",
+			"filename":       "requirements.txt",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if requirement == nil || requirement.Filename == nil || *requirement.Filename != "requirements.txt" {
+		t.Fatalf("unexpected requirement: %+v", requirement)
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirements_DuplicatePipRequirementsIsError(t *testing.T) {
+	_, err := expandArmBigDataPoolLibraryRequirements([]interface{}{
+		map[string]interface{}{
+			"content":        "numpy==1.19.0\n",
+			"content_base64": "",
+			"source_file":    "",
+			"filename":       "requirements.txt",
+		},
+		map[string]interface{}{
+			"content":        "pandas==1.1.0\n",
+			"content_base64": "",
+			"source_file":    "",
+			"filename":       "requirements.txt",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an error when two pip `library_requirement` blocks are configured")
+	}
+}
+
+func TestExpandArmBigDataPoolLibraryRequirements_PipAndCondaTogetherIsError(t *testing.T) {
+	_, err := expandArmBigDataPoolLibraryRequirements([]interface{}{
+		map[string]interface{}{
+			"content":        "numpy==1.19.0\n",
+			"content_base64": "",
+			"source_file":    "",
+			"filename":       "requirements.txt",
+		},
+		map[string]interface{}{
+			"content":        "dependencies:\n  - numpy\n",
+			"content_base64": "",
+			"source_file":    "",
+			"filename":       "environment.yml",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected a diagnostic explaining the SDK can't persist both a pip and a conda `library_requirement` yet")
+	}
+}
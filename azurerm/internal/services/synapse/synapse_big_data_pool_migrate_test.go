@@ -0,0 +1,96 @@
+package synapse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceArmSynapseBigDataPoolUpgradeV0ToV1(t *testing.T) {
+	// a v0 state fixture using only the legacy node_size/spark_version values
+	v0State := map[string]interface{}{
+		"name":             "testpool",
+		"node_size":        "Medium",
+		"node_size_family": "MemoryOptimized",
+		"spark_version":    "2.4",
+	}
+
+	v1State, err := resourceArmSynapseBigDataPoolUpgradeV0ToV1(v0State, nil)
+	if err != nil {
+		t.Fatalf("unexpected error upgrading v0 state: %+v", err)
+	}
+
+	if !reflect.DeepEqual(v0State, v1State) {
+		t.Fatalf("expected the legacy-only v0 state to carry forward unchanged, got %+v", v1State)
+	}
+}
+
+func TestResourceArmSynapseBigDataPoolNodeSizeAcceptsNewTiers(t *testing.T) {
+	validateFunc := resourceArmSynapseBigDataPool().Schema["node_size"].ValidateFunc
+
+	for _, nodeSize := range []string{"Small", "Medium", "Large", "XLarge", "XXLarge", "XXXLarge"} {
+		if _, errs := validateFunc(nodeSize, "node_size"); len(errs) > 0 {
+			t.Errorf("expected `node_size` %q to be valid, got errors: %+v", nodeSize, errs)
+		}
+	}
+
+	if _, errs := validateFunc("NotARealSize", "node_size"); len(errs) == 0 {
+		t.Error("expected an invalid `node_size` to be rejected")
+	}
+}
+
+func TestResourceArmSynapseBigDataPoolSparkVersionAcceptsV3(t *testing.T) {
+	validateFunc := resourceArmSynapseBigDataPool().Schema["spark_version"].ValidateFunc
+
+	for _, sparkVersion := range []string{"2.4", "3.1"} {
+		if _, errs := validateFunc(sparkVersion, "spark_version"); len(errs) > 0 {
+			t.Errorf("expected `spark_version` %q to be valid, got errors: %+v", sparkVersion, errs)
+		}
+	}
+
+	if _, errs := validateFunc("1.0", "spark_version"); len(errs) == 0 {
+		t.Error("expected an unsupported `spark_version` to be rejected")
+	}
+}
+
+func TestResourceArmSynapseBigDataPoolV0SchemaIncludesFieldsAddedBeforeV1(t *testing.T) {
+	v0Schema := resourceArmSynapseBigDataPoolV0Schema()
+
+	for _, field := range []string{"name_prefix"} {
+		if _, ok := v0Schema.Schema[field]; !ok {
+			t.Errorf("expected the v0 schema to include %q, since it shipped before schema version 1 existed", field)
+		}
+	}
+
+	libraryRequirement, ok := v0Schema.Schema["library_requirement"]
+	if !ok {
+		t.Fatal("expected the v0 schema to declare `library_requirement`")
+	}
+	if libraryRequirement.MaxItems != 2 {
+		t.Errorf("expected the v0 `library_requirement` to allow 2 items (pip + conda), got %d", libraryRequirement.MaxItems)
+	}
+
+	elem, ok := libraryRequirement.Elem.(*schema.Resource)
+	if !ok {
+		t.Fatal("expected `library_requirement`'s Elem to be a *schema.Resource")
+	}
+	for _, field := range []string{"content", "content_base64", "source_file", "filename"} {
+		if _, ok := elem.Schema[field]; !ok {
+			t.Errorf("expected the v0 `library_requirement` block to include %q", field)
+		}
+	}
+}
+
+func TestResourceArmSynapseBigDataPoolSchemaVersion(t *testing.T) {
+	resource := resourceArmSynapseBigDataPool()
+	if resource.SchemaVersion != 1 {
+		t.Fatalf("expected SchemaVersion 1, got %d", resource.SchemaVersion)
+	}
+	if len(resource.StateUpgraders) != 1 {
+		t.Fatalf("expected exactly one StateUpgrader from v0, got %d", len(resource.StateUpgraders))
+	}
+	if resource.StateUpgraders[0].Version != 0 {
+		t.Fatalf("expected the StateUpgrader to upgrade from version 0, got %d", resource.StateUpgraders[0].Version)
+	}
+}
@@ -0,0 +1,40 @@
+package synapse
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateArmBigDataPoolNameFromPrefix_KeepsThePrefix(t *testing.T) {
+	prefix := "devpool"
+
+	generated, err := generateArmBigDataPoolNameFromPrefix(prefix)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if !strings.HasPrefix(generated, prefix) {
+		t.Fatalf("expected generated name %q to start with `name_prefix` %q", generated, prefix)
+	}
+
+	if len(generated) > synapseBigDataPoolNameMaxLength {
+		t.Fatalf("expected generated name %q to be at most %d characters, got %d", generated, synapseBigDataPoolNameMaxLength, len(generated))
+	}
+}
+
+func TestGenerateArmBigDataPoolNameFromPrefix_DiffersBetweenCalls(t *testing.T) {
+	prefix := "pool"
+
+	first, err := generateArmBigDataPoolNameFromPrefix(prefix)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	second, err := generateArmBigDataPoolNameFromPrefix(prefix)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if first == second {
+		t.Fatalf("expected two generated names from the same `name_prefix` to differ, both were %q", first)
+	}
+}
@@ -0,0 +1,130 @@
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/2019-06-01-preview/synapse"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmSynapseBigDataPools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSynapseBigDataPoolsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"synapse_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SynapseWorkspaceID,
+			},
+
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"node_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"spark_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSynapseBigDataPoolsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.BigDataPoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.SynapseWorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	pools, err := listArmSynapseBigDataPools(ctx, client, workspaceId.ResourceGroup, workspaceId.Name)
+	if err != nil {
+		return fmt.Errorf("listing Synapse BigDataPools (Resource Group %q / workspaceName %q): %+v", workspaceId.ResourceGroup, workspaceId.Name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/bigDataPools", workspaceId.String()))
+	d.Set("synapse_workspace_id", workspaceId.String())
+	return d.Set("pools", pools)
+}
+
+func listArmSynapseBigDataPools(ctx context.Context, client *synapse.BigDataPoolsClient, resourceGroup string, workspaceName string) ([]interface{}, error) {
+	iter, err := client.ListByWorkspaceComplete(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0)
+	for iter.NotDone() {
+		pool := iter.Value()
+
+		var id, name, nodeSize, sparkVersion string
+		var nodeCount int32
+
+		if pool.ID != nil {
+			id = *pool.ID
+		}
+		if pool.Name != nil {
+			name = *pool.Name
+		}
+		if props := pool.BigDataPoolResourceProperties; props != nil {
+			nodeSize = string(props.NodeSize)
+			if props.NodeCount != nil {
+				nodeCount = *props.NodeCount
+			}
+			if props.SparkVersion != nil {
+				sparkVersion = *props.SparkVersion
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":            id,
+			"name":          name,
+			"node_size":     nodeSize,
+			"node_count":    int(nodeCount),
+			"spark_version": sparkVersion,
+		})
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}